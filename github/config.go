@@ -27,6 +27,11 @@ type Config struct {
 	RetryableErrors  map[int]bool
 	MaxRetries       int
 	ParallelRequests bool
+	UserAgentSuffix  string
+
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
 }
 
 type Owner struct {
@@ -77,6 +82,10 @@ func (c *Config) AuthenticatedHTTPClient() *http.Client {
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: c.Token},
 	)
+	// oauth2 builds on the transport of the client stored in the context.
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+		Transport: c.tuneHTTPTransport(http.DefaultTransport.(*http.Transport).Clone()),
+	})
 	client := oauth2.NewClient(ctx, ts)
 
 	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
@@ -87,10 +96,26 @@ func (c *Config) Anonymous() bool {
 }
 
 func (c *Config) AnonymousHTTPClient() *http.Client {
-	client := &http.Client{Transport: &http.Transport{}}
+	client := &http.Client{Transport: c.tuneHTTPTransport(&http.Transport{})}
 	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
 }
 
+// tuneHTTPTransport applies the connection pooling settings of the config to
+// the transport. Unset settings keep the values of the transport.
+func (c *Config) tuneHTTPTransport(transport *http.Transport) *http.Transport {
+	if c.MaxIdleConns > 0 {
+		transport.MaxIdleConns = c.MaxIdleConns
+	}
+	if c.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = c.MaxIdleConnsPerHost
+	}
+	if c.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = c.IdleConnTimeout
+	}
+
+	return transport
+}
+
 func (c *Config) NewGraphQLClient(client *http.Client) (*githubv4.Client, error) {
 	var path string
 	if c.IsGHES {
@@ -110,6 +135,9 @@ func (c *Config) NewRESTClient(client *http.Client) (*github.Client, error) {
 
 	v3client := github.NewClient(client)
 	v3client.BaseURL = c.BaseURL.JoinPath(path)
+	if c.UserAgentSuffix != "" {
+		v3client.UserAgent = fmt.Sprintf("%s %s", v3client.UserAgent, c.UserAgentSuffix)
+	}
 
 	return v3client, nil
 }
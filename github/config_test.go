@@ -4,8 +4,11 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
+	"github.com/google/go-github/v83/github"
 	"github.com/shurcooL/githubv4"
 )
 
@@ -514,3 +517,83 @@ func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 	}
 	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
 }
+
+func TestNewRESTClientUserAgentSuffix(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		suffix    string
+		userAgent string
+	}{
+		{
+			name:      "default user agent",
+			suffix:    "",
+			userAgent: "go-github/" + github.Version,
+		},
+		{
+			name:      "user agent with suffix",
+			suffix:    "deploy-pipeline/1.2",
+			userAgent: "go-github/" + github.Version + " deploy-pipeline/1.2",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := githubApiMock([]*mockResponse{
+				{
+					ExpectedUri: "/meta",
+					ExpectedHeaders: map[string]string{
+						"User-Agent": tc.userAgent,
+					},
+					ResponseBody: `{}`,
+					StatusCode:   200,
+				},
+			})
+			defer ts.Close()
+
+			baseURL, err := url.Parse(ts.URL + "/")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			config := Config{BaseURL: baseURL, IsGHES: false, UserAgentSuffix: tc.suffix}
+			client, err := config.NewRESTClient(http.DefaultClient)
+			if err != nil {
+				t.Fatalf("unexpected error creating client: %s", err)
+			}
+
+			if _, _, err := client.Meta.Get(t.Context()); err != nil {
+				t.Fatalf("expected User-Agent %q to be sent: %s", tc.userAgent, err)
+			}
+		})
+	}
+}
+
+func TestConfigTuneHTTPTransport(t *testing.T) {
+	t.Run("applies the configured connection pooling settings", func(t *testing.T) {
+		config := Config{MaxIdleConns: 200, MaxIdleConnsPerHost: 50, IdleConnTimeout: 30 * time.Second}
+		transport := config.tuneHTTPTransport(http.DefaultTransport.(*http.Transport).Clone())
+
+		if transport.MaxIdleConns != 200 {
+			t.Errorf("expected MaxIdleConns to be 200, got %d", transport.MaxIdleConns)
+		}
+		if transport.MaxIdleConnsPerHost != 50 {
+			t.Errorf("expected MaxIdleConnsPerHost to be 50, got %d", transport.MaxIdleConnsPerHost)
+		}
+		if transport.IdleConnTimeout != 30*time.Second {
+			t.Errorf("expected IdleConnTimeout to be 30s, got %s", transport.IdleConnTimeout)
+		}
+	})
+
+	t.Run("keeps the transport values when unset", func(t *testing.T) {
+		defaultTransport := http.DefaultTransport.(*http.Transport)
+		transport := (&Config{}).tuneHTTPTransport(defaultTransport.Clone())
+
+		if transport.MaxIdleConns != defaultTransport.MaxIdleConns {
+			t.Errorf("expected MaxIdleConns to be %d, got %d", defaultTransport.MaxIdleConns, transport.MaxIdleConns)
+		}
+		if transport.MaxIdleConnsPerHost != defaultTransport.MaxIdleConnsPerHost {
+			t.Errorf("expected MaxIdleConnsPerHost to be %d, got %d", defaultTransport.MaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+		}
+		if transport.IdleConnTimeout != defaultTransport.IdleConnTimeout {
+			t.Errorf("expected IdleConnTimeout to be %s, got %s", defaultTransport.IdleConnTimeout, transport.IdleConnTimeout)
+		}
+	})
+}
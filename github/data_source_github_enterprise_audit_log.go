@@ -0,0 +1,179 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGithubEnterpriseAuditLog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGithubEnterpriseAuditLogRead,
+		Description: "Use this data source to query audit log events of a GitHub enterprise.",
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"phrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A search phrase used to filter the audit log events.",
+			},
+			"include": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "web",
+				Description:      "The event types to include. Can be one of 'web', 'git' or 'all'.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"web", "git", "all"}, false)),
+			},
+			"created_after": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return events created at or after this RFC3339 timestamp.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"created_before": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return events created at or before this RFC3339 timestamp.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"max_results": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				Description:      "The maximum number of events to return.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of audit log events, most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the action that was performed.",
+						},
+						"actor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The actor who performed the action.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the event was created.",
+						},
+						"raw": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full event payload as a JSON string.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubEnterpriseAuditLogRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	maxResults := d.Get("max_results").(int)
+
+	options := &github.GetAuditLogOptions{
+		Include: github.Ptr(d.Get("include").(string)),
+	}
+	if phrase := buildEnterpriseAuditLogPhrase(d); phrase != "" {
+		options.Phrase = github.Ptr(phrase)
+	}
+
+	results := make([]map[string]any, 0)
+	for len(results) < maxResults {
+		options.PerPage = min(maxPerPage, maxResults-len(results))
+
+		entries, resp, err := client.Enterprise.GetAuditLog(ctx, enterpriseSlug, options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		events, err := flattenEnterpriseAuditLogEntries(entries)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		results = append(results, events...)
+
+		if resp.After == "" || len(entries) == 0 {
+			break
+		}
+		options.After = resp.After
+	}
+
+	if len(results) > maxResults {
+		results = results[:maxResults]
+	}
+
+	d.SetId(enterpriseSlug)
+	if err := d.Set("events", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// buildEnterpriseAuditLogPhrase combines the phrase with the created_after and
+// created_before qualifiers understood by the audit log search syntax.
+func buildEnterpriseAuditLogPhrase(d *schema.ResourceData) string {
+	terms := make([]string, 0)
+	if v, ok := d.GetOk("phrase"); ok {
+		terms = append(terms, v.(string))
+	}
+	if v, ok := d.GetOk("created_after"); ok {
+		terms = append(terms, "created:>="+v.(string))
+	}
+	if v, ok := d.GetOk("created_before"); ok {
+		terms = append(terms, "created:<="+v.(string))
+	}
+
+	return strings.Join(terms, " ")
+}
+
+func flattenEnterpriseAuditLogEntries(entries []*github.AuditEntry) ([]map[string]any, error) {
+	results := make([]map[string]any, 0, len(entries))
+
+	for _, entry := range entries {
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		createdAt := entry.GetCreatedAt()
+		if createdAt.IsZero() {
+			createdAt = entry.GetTimestamp()
+		}
+
+		result := map[string]any{
+			"action": entry.GetAction(),
+			"actor":  entry.GetActor(),
+			"raw":    string(raw),
+		}
+		if !createdAt.IsZero() {
+			result["created_at"] = createdAt.UTC().Format(time.RFC3339)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
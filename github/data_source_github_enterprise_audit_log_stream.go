@@ -0,0 +1,91 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubEnterpriseAuditLogStream() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGithubEnterpriseAuditLogStreamRead,
+		Description: "Use this data source to read an audit log stream of a GitHub enterprise.",
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"stream_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the audit log stream.",
+			},
+			"stream_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The destination type of the audit log stream.",
+			},
+			"stream_details": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A summary of the audit log stream destination.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the audit log stream is enabled.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the audit log stream was created.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the audit log stream was last updated.",
+			},
+		},
+	}
+}
+
+func dataSourceGithubEnterpriseAuditLogStreamRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	streamId := d.Get("stream_id").(int)
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("enterprises/%s/audit-log/streams/%d", enterpriseSlug, streamId), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var stream enterpriseAuditLogStream
+	_, err = client.Do(ctx, req, &stream)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return diag.Errorf("audit log stream %d not found in enterprise %s", streamId, enterpriseSlug)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(enterpriseSlug, strconv.Itoa(streamId)))
+	for key, value := range flattenEnterpriseAuditLogStream(&stream) {
+		if key == "stream_id" {
+			continue
+		}
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// enterpriseAuditLogStreamKey is the public key used to encrypt the
+// credentials of the audit log streams of an enterprise.
+type enterpriseAuditLogStreamKey struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+}
+
+func dataSourceGithubEnterpriseAuditLogStreamEncryptedSecret() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGithubEnterpriseAuditLogStreamEncryptedSecretRead,
+		Description: "Use this data source to encrypt a secret with the audit log stream key of a GitHub enterprise.",
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret to encrypt.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key the secret was encrypted with.",
+			},
+			"encrypted_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret encrypted with the audit log stream key, encoded in base64.",
+			},
+		},
+	}
+}
+
+func dataSourceGithubEnterpriseAuditLogStreamEncryptedSecretRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	// go-github does not wrap the audit log streaming endpoints.
+	req, err := client.NewRequest("GET", fmt.Sprintf("enterprises/%s/audit-log/stream-key", enterpriseSlug), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var key enterpriseAuditLogStreamKey
+	_, err = client.Do(ctx, req, &key)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	encryptedBytes, err := encryptPlaintext(d.Get("plaintext").(string), key.Key)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(enterpriseSlug, key.KeyID))
+	if err = d.Set("key_id", key.KeyID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("encrypted_value", base64.StdEncoding.EncodeToString(encryptedBytes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
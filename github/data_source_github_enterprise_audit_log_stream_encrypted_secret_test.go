@@ -0,0 +1,87 @@
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestAccGithubEnterpriseAuditLogStreamEncryptedSecretDataSource(t *testing.T) {
+	t.Run("encrypts a secret that decrypts with the stream key", func(t *testing.T) {
+		publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/enterprises/my-enterprise/audit-log/stream-key",
+				ExpectedMethod: "GET",
+				ResponseBody:   fmt.Sprintf(`{"key_id": "123456", "key": "%s"}`, base64.StdEncoding.EncodeToString(publicKey[:])),
+				StatusCode:     200,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{v3client: client}
+		d := schema.TestResourceDataRaw(t, dataSourceGithubEnterpriseAuditLogStreamEncryptedSecret().Schema, map[string]any{
+			"enterprise_slug": "my-enterprise",
+			"plaintext":       "https://example.blob.core.windows.net/audit?sv=2024-01-01&sig=secret",
+		})
+
+		diags := dataSourceGithubEnterpriseAuditLogStreamEncryptedSecretRead(t.Context(), d, meta)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("key_id").(string); got != "123456" {
+			t.Errorf("expected key_id 123456, got %s", got)
+		}
+
+		encryptedBytes, err := base64.StdEncoding.DecodeString(d.Get("encrypted_value").(string))
+		if err != nil {
+			t.Fatalf("encrypted_value is not valid base64: %s", err)
+		}
+		decrypted, ok := box.OpenAnonymous(nil, encryptedBytes, publicKey, privateKey)
+		if !ok {
+			t.Fatal("could not decrypt encrypted_value with the stream key")
+		}
+		if string(decrypted) != "https://example.blob.core.windows.net/audit?sv=2024-01-01&sig=secret" {
+			t.Errorf("unexpected decrypted value %q", string(decrypted))
+		}
+	})
+
+	t.Run("encrypts a secret with the enterprise stream key without error", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			data "github_enterprise_audit_log_stream_encrypted_secret" "test" {
+				enterprise_slug = "%s"
+				plaintext       = "not-a-real-secret"
+			}
+		`, testAccConf.enterpriseSlug)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessMode(t, enterprise) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.github_enterprise_audit_log_stream_encrypted_secret.test", "key_id"),
+						resource.TestCheckResourceAttrSet("data.github_enterprise_audit_log_stream_encrypted_secret.test", "encrypted_value"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGithubEnterpriseAuditLogStreamDataSource(t *testing.T) {
+	newMeta := func(t *testing.T, responses []*mockResponse) *Owner {
+		ts := githubApiMock(responses)
+		t.Cleanup(ts.Close)
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		return &Owner{v3client: client}
+	}
+
+	t.Run("reads an audit log stream from a mocked response", func(t *testing.T) {
+		meta := newMeta(t, []*mockResponse{
+			{
+				ExpectedUri:    "/enterprises/my-enterprise/audit-log/streams/42",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"id": 42, "stream_type": "Splunk", "stream_details": "https://splunk.example.com", "enabled": false, "updated_at": "2024-01-02T03:04:05Z"}`,
+				StatusCode:     200,
+			},
+		})
+		d := schema.TestResourceDataRaw(t, dataSourceGithubEnterpriseAuditLogStream().Schema, map[string]any{
+			"enterprise_slug": "my-enterprise",
+			"stream_id":       42,
+		})
+
+		diags := dataSourceGithubEnterpriseAuditLogStreamRead(t.Context(), d, meta)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if d.Id() != "my-enterprise:42" {
+			t.Errorf("expected ID my-enterprise:42, got %s", d.Id())
+		}
+		if got := d.Get("stream_type").(string); got != "Splunk" {
+			t.Errorf("expected stream_type Splunk, got %s", got)
+		}
+		if got := d.Get("enabled").(bool); got {
+			t.Errorf("expected stream to be disabled")
+		}
+		if got := d.Get("updated_at").(string); got != "2024-01-02T03:04:05Z" {
+			t.Errorf("expected updated_at 2024-01-02T03:04:05Z, got %s", got)
+		}
+	})
+
+	t.Run("reports a missing audit log stream clearly", func(t *testing.T) {
+		meta := newMeta(t, []*mockResponse{
+			{
+				ExpectedUri:    "/enterprises/my-enterprise/audit-log/streams/404",
+				ExpectedMethod: "GET",
+				ResponseBody:   `{"message": "Not Found"}`,
+				StatusCode:     404,
+			},
+		})
+		d := schema.TestResourceDataRaw(t, dataSourceGithubEnterpriseAuditLogStream().Schema, map[string]any{
+			"enterprise_slug": "my-enterprise",
+			"stream_id":       404,
+		})
+
+		diags := dataSourceGithubEnterpriseAuditLogStreamRead(t.Context(), d, meta)
+		if !diags.HasError() {
+			t.Fatal("expected an error for a missing stream")
+		}
+		if !strings.Contains(diags[0].Summary, "audit log stream 404 not found in enterprise my-enterprise") {
+			t.Errorf("unexpected error: %s", diags[0].Summary)
+		}
+	})
+
+	t.Run("reads an existing audit log stream without error", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			data "github_enterprise_audit_log_streams" "all" {
+				enterprise_slug = "%[1]s"
+			}
+
+			data "github_enterprise_audit_log_stream" "test" {
+				count = length(data.github_enterprise_audit_log_streams.all.streams) > 0 ? 1 : 0
+
+				enterprise_slug = "%[1]s"
+				stream_id       = data.github_enterprise_audit_log_streams.all.streams[0].stream_id
+			}
+		`, testAccConf.enterpriseSlug)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessMode(t, enterprise) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources["data.github_enterprise_audit_log_stream.test.0"]
+						if !ok {
+							// The enterprise has no audit log stream to read.
+							return nil
+						}
+
+						enabled := s.RootModule().Resources["data.github_enterprise_audit_log_streams.all"].Primary.Attributes["streams.0.enabled"]
+						if rs.Primary.Attributes["enabled"] != enabled {
+							return fmt.Errorf("expected enabled to be %s, got %s", enabled, rs.Primary.Attributes["enabled"])
+						}
+
+						return nil
+					},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// enterpriseAuditLogStream is an audit log stream of an enterprise as returned
+// by the REST API. go-github does not wrap the audit log streaming endpoints.
+type enterpriseAuditLogStream struct {
+	ID            int64             `json:"id"`
+	StreamType    string            `json:"stream_type"`
+	StreamDetails string            `json:"stream_details"`
+	Enabled       bool              `json:"enabled"`
+	CreatedAt     *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt     *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+func dataSourceGithubEnterpriseAuditLogStreams() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGithubEnterpriseAuditLogStreamsRead,
+		Description: "Use this data source to list the audit log streams of a GitHub enterprise.",
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"streams": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of audit log streams of the enterprise.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stream_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the audit log stream.",
+						},
+						"stream_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The destination type of the audit log stream.",
+						},
+						"stream_details": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A summary of the audit log stream destination.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the audit log stream is enabled.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the audit log stream was created.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the audit log stream was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubEnterpriseAuditLogStreamsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	results := make([]map[string]any, 0)
+	page := 1
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("enterprises/%s/audit-log/streams?page=%d&per_page=%d", enterpriseSlug, page, maxPerPage), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var streams []*enterpriseAuditLogStream
+		resp, err := client.Do(ctx, req, &streams)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, stream := range streams {
+			results = append(results, flattenEnterpriseAuditLogStream(stream))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+
+	d.SetId(enterpriseSlug)
+	if err := d.Set("streams", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenEnterpriseAuditLogStream(stream *enterpriseAuditLogStream) map[string]any {
+	result := map[string]any{
+		"stream_id":      int(stream.ID),
+		"stream_type":    stream.StreamType,
+		"stream_details": stream.StreamDetails,
+		"enabled":        stream.Enabled,
+	}
+	if stream.CreatedAt != nil {
+		result["created_at"] = stream.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	if stream.UpdatedAt != nil {
+		result["updated_at"] = stream.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	return result
+}
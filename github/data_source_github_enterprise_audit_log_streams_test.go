@@ -0,0 +1,90 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubEnterpriseAuditLogStreamsDataSource(t *testing.T) {
+	t.Run("pages through the audit log streams of a mocked enterprise", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/enterprises/my-enterprise/audit-log/streams?page=1&per_page=100",
+				ExpectedMethod: "GET",
+				ResponseHeaders: map[string]string{
+					"Link": `<https://api.github.com/enterprises/my-enterprise/audit-log/streams?page=2&per_page=100>; rel="next"`,
+				},
+				ResponseBody: `[
+					{"id": 1, "stream_type": "Splunk", "stream_details": "https://splunk.example.com", "enabled": true, "created_at": "2024-01-02T03:04:05Z"}
+				]`,
+				StatusCode: 200,
+			},
+			{
+				ExpectedUri:    "/enterprises/my-enterprise/audit-log/streams?page=2&per_page=100",
+				ExpectedMethod: "GET",
+				ResponseBody: `[
+					{"id": 2, "stream_type": "Azure Blob Storage", "stream_details": "audit-container", "enabled": false}
+				]`,
+				StatusCode: 200,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{v3client: client}
+		d := schema.TestResourceDataRaw(t, dataSourceGithubEnterpriseAuditLogStreams().Schema, map[string]any{
+			"enterprise_slug": "my-enterprise",
+		})
+
+		diags := dataSourceGithubEnterpriseAuditLogStreamsRead(t.Context(), d, meta)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("streams.#").(int); got != 2 {
+			t.Fatalf("expected 2 streams, got %d", got)
+		}
+		if got := d.Get("streams.0.stream_id").(int); got != 1 {
+			t.Errorf("expected first stream_id 1, got %d", got)
+		}
+		if got := d.Get("streams.0.created_at").(string); got != "2024-01-02T03:04:05Z" {
+			t.Errorf("expected first created_at 2024-01-02T03:04:05Z, got %s", got)
+		}
+		if got := d.Get("streams.1.stream_details").(string); got != "audit-container" {
+			t.Errorf("expected second stream_details audit-container, got %s", got)
+		}
+		if got := d.Get("streams.1.enabled").(bool); got {
+			t.Errorf("expected second stream to be disabled")
+		}
+	})
+
+	t.Run("lists audit log streams without error", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			data "github_enterprise_audit_log_streams" "test" {
+				enterprise_slug = "%s"
+			}
+		`, testAccConf.enterpriseSlug)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessMode(t, enterprise) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.github_enterprise_audit_log_streams.test", "enterprise_slug", testAccConf.enterpriseSlug),
+						resource.TestCheckResourceAttrSet("data.github_enterprise_audit_log_streams.test", "streams.#"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,100 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBuildEnterpriseAuditLogPhrase(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		raw    map[string]any
+		phrase string
+	}{
+		{
+			name:   "no filters",
+			raw:    map[string]any{},
+			phrase: "",
+		},
+		{
+			name:   "phrase only",
+			raw:    map[string]any{"phrase": "action:org.create"},
+			phrase: "action:org.create",
+		},
+		{
+			name:   "created range only",
+			raw:    map[string]any{"created_after": "2024-01-01T00:00:00Z", "created_before": "2024-02-01T00:00:00Z"},
+			phrase: "created:>=2024-01-01T00:00:00Z created:<=2024-02-01T00:00:00Z",
+		},
+		{
+			name:   "phrase and created after",
+			raw:    map[string]any{"phrase": "actor:octocat", "created_after": "2024-01-01T00:00:00Z"},
+			phrase: "actor:octocat created:>=2024-01-01T00:00:00Z",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceGithubEnterpriseAuditLog().Schema, tc.raw)
+
+			if phrase := buildEnterpriseAuditLogPhrase(d); phrase != tc.phrase {
+				t.Errorf("expected phrase %q, got %q", tc.phrase, phrase)
+			}
+		})
+	}
+}
+
+func TestFlattenEnterpriseAuditLogEntries(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	entries := []*github.AuditEntry{
+		{
+			Action:    github.Ptr("org.create"),
+			Actor:     github.Ptr("octocat"),
+			CreatedAt: &github.Timestamp{Time: createdAt},
+		},
+	}
+
+	events, err := flattenEnterpriseAuditLogEntries(entries)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	if events[0]["created_at"] != "2024-01-02T02:04:05Z" {
+		t.Errorf("expected created_at in UTC, got %v", events[0]["created_at"])
+	}
+}
+
+func TestAccGithubEnterpriseAuditLogDataSource(t *testing.T) {
+	config := fmt.Sprintf(`
+			data "github_enterprise_audit_log" "test" {
+				enterprise_slug = "%s"
+				include         = "all"
+				max_results     = 5
+			}
+		`,
+		testAccConf.enterpriseSlug,
+	)
+
+	check := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("data.github_enterprise_audit_log.test", "enterprise_slug", testAccConf.enterpriseSlug),
+		resource.TestCheckResourceAttrSet("data.github_enterprise_audit_log.test", "events.0.action"),
+		resource.TestCheckResourceAttrSet("data.github_enterprise_audit_log.test", "events.0.raw"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { skipUnlessMode(t, enterprise) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	},
+	)
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func dataSourceGithubEnterpriseOrganizations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGithubEnterpriseOrganizationsRead,
+		Description: "Use this data source to retrieve all organizations of a GitHub enterprise.",
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"organizations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of organizations in the enterprise.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The node ID of the organization.",
+						},
+						"database_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The database ID of the organization.",
+						},
+						"login": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The login of the organization.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the organization.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubEnterpriseOrganizationsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	var query struct {
+		Enterprise struct {
+			ID            githubv4.String
+			Organizations struct {
+				Nodes []struct {
+					ID         githubv4.String
+					DatabaseId githubv4.Int
+					Login      githubv4.String
+					Name       githubv4.String
+				}
+				PageInfo PageInfo
+			} `graphql:"organizations(first: 100, after: $cursor)"`
+		} `graphql:"enterprise(slug: $slug)"`
+	}
+
+	variables := map[string]any{
+		"slug":   githubv4.String(enterpriseSlug),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	organizations := make([]map[string]any, 0)
+	for {
+		err := client.Query(ctx, &query, variables)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if query.Enterprise.ID == "" {
+			return diag.Errorf("could not find enterprise %s", enterpriseSlug)
+		}
+
+		for _, org := range query.Enterprise.Organizations.Nodes {
+			organizations = append(organizations, map[string]any{
+				"id":          org.ID,
+				"database_id": org.DatabaseId,
+				"login":       org.Login,
+				"name":        org.Name,
+			})
+		}
+
+		if !query.Enterprise.Organizations.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Enterprise.Organizations.PageInfo.EndCursor)
+	}
+
+	d.SetId(enterpriseSlug)
+	if err := d.Set("organizations", organizations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
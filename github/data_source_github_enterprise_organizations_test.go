@@ -0,0 +1,37 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubEnterpriseOrganizationsDataSource(t *testing.T) {
+	config := fmt.Sprintf(`
+			data "github_enterprise_organizations" "test" {
+				enterprise_slug = "%s"
+			}
+		`,
+		testAccConf.enterpriseSlug,
+	)
+
+	check := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("data.github_enterprise_organizations.test", "enterprise_slug", testAccConf.enterpriseSlug),
+		resource.TestCheckResourceAttrSet("data.github_enterprise_organizations.test", "organizations.0.id"),
+		resource.TestCheckResourceAttrSet("data.github_enterprise_organizations.test", "organizations.0.database_id"),
+		resource.TestCheckResourceAttrSet("data.github_enterprise_organizations.test", "organizations.0.login"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { skipUnlessMode(t, enterprise) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  check,
+			},
+		},
+	},
+	)
+}
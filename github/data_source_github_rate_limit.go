@@ -0,0 +1,111 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRateLimit() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGithubRateLimitRead,
+		Description: "Use this data source to retrieve the current API rate limit status of the authenticated client.",
+
+		Schema: map[string]*schema.Schema{
+			"core": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rate limit status of the core REST API.",
+				Elem:        rateLimitSchema(),
+			},
+			"search": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rate limit status of the search API.",
+				Elem:        rateLimitSchema(),
+			},
+			"graphql": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rate limit status of the GraphQL API.",
+				Elem:        rateLimitSchema(),
+			},
+			"audit_log": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rate limit status of the audit log API.",
+				Elem:        rateLimitSchema(),
+			},
+		},
+	}
+}
+
+func rateLimitSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of requests permitted per hour.",
+			},
+			"remaining": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests remaining in the current rate limit window.",
+			},
+			"used": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests made in the current rate limit window.",
+			},
+			"reset": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the current rate limit window resets.",
+			},
+		},
+	}
+}
+
+func dataSourceGithubRateLimitRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+
+	limits, _, err := client.RateLimit.Get(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("github-rate-limit")
+	if err := d.Set("core", flattenRateLimit(limits.GetCore())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("search", flattenRateLimit(limits.GetSearch())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("graphql", flattenRateLimit(limits.GetGraphQL())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("audit_log", flattenRateLimit(limits.GetAuditLog())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenRateLimit(rate *github.Rate) []any {
+	if rate == nil {
+		return []any{}
+	}
+
+	return []any{
+		map[string]any{
+			"limit":     rate.Limit,
+			"remaining": rate.Remaining,
+			"used":      rate.Used,
+			"reset":     rate.Reset.UTC().Format(time.RFC3339),
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package github
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v83/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubRateLimitDataSource(t *testing.T) {
+	t.Run("reads rate limits from a mocked response", func(t *testing.T) {
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri:    "/rate_limit",
+				ExpectedMethod: "GET",
+				ResponseBody: `{
+					"resources": {
+						"core": {"limit": 5000, "remaining": 4999, "used": 1, "reset": 1700000000},
+						"graphql": {"limit": 5000, "remaining": 4990, "used": 10, "reset": 1700000000},
+						"audit_log": {"limit": 1750, "remaining": 1700, "used": 50, "reset": 1700000000}
+					}
+				}`,
+				StatusCode: 200,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(nil)
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		meta := &Owner{v3client: client}
+		d := schema.TestResourceDataRaw(t, dataSourceGithubRateLimit().Schema, map[string]any{})
+
+		diags := dataSourceGithubRateLimitRead(t.Context(), d, meta)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("core.0.remaining").(int); got != 4999 {
+			t.Errorf("expected core remaining 4999, got %d", got)
+		}
+		if got := d.Get("audit_log.0.limit").(int); got != 1750 {
+			t.Errorf("expected audit_log limit 1750, got %d", got)
+		}
+		if got := d.Get("graphql.0.reset").(string); got != "2023-11-14T22:13:20Z" {
+			t.Errorf("expected graphql reset 2023-11-14T22:13:20Z, got %s", got)
+		}
+		if got := d.Get("search.#").(int); got != 0 {
+			t.Errorf("expected no search rate limit, got %d entries", got)
+		}
+	})
+
+	t.Run("reads rate limits without error", func(t *testing.T) {
+		config := `data "github_rate_limit" "test" {}`
+
+		resource.Test(t, resource.TestCase{
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.github_rate_limit.test", "core.0.limit"),
+						resource.TestCheckResourceAttrSet("data.github_rate_limit.test", "core.0.remaining"),
+						resource.TestCheckResourceAttrSet("data.github_rate_limit.test", "core.0.reset"),
+					),
+				},
+			},
+		})
+	})
+}
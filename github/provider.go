@@ -93,6 +93,24 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["parallel_requests"],
 			},
+			"max_idle_conns": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: descriptions["max_idle_conns"],
+			},
+			"max_idle_conns_per_host": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: descriptions["max_idle_conns_per_host"],
+			},
+			"idle_conn_timeout_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     90000,
+				Description: descriptions["idle_conn_timeout_ms"],
+			},
 			"app_auth": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -123,6 +141,11 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["user_agent_suffix"],
+			},
 			// https://developer.github.com/guides/traversing-with-pagination/#basics-of-pagination
 			"max_per_page": {
 				Type:        schema.TypeInt,
@@ -213,10 +236,14 @@ func Provider() *schema.Provider {
 			"github_user_invitation_accepter":                                       resourceGithubUserInvitationAccepter(),
 			"github_user_ssh_key":                                                   resourceGithubUserSshKey(),
 			"github_enterprise_organization":                                        resourceGithubEnterpriseOrganization(),
+			"github_enterprise_organization_settings":                               resourceGithubEnterpriseOrganizationSettings(),
 			"github_enterprise_actions_runner_group":                                resourceGithubActionsEnterpriseRunnerGroup(),
 			"github_enterprise_actions_workflow_permissions":                        resourceGithubEnterpriseActionsWorkflowPermissions(),
 			"github_actions_organization_workflow_permissions":                      resourceGithubActionsOrganizationWorkflowPermissions(),
 			"github_enterprise_security_analysis_settings":                          resourceGithubEnterpriseSecurityAnalysisSettings(),
+			"github_enterprise_ip_allow_list_entry":                                 resourceGithubEnterpriseIpAllowListEntry(),
+			"github_enterprise_ip_allow_list_settings":                              resourceGithubEnterpriseIpAllowListSettings(),
+			"github_enterprise_member":                                              resourceGithubEnterpriseMember(),
 			"github_workflow_repository_permissions":                                resourceGithubWorkflowRepositoryPermissions(),
 		},
 
@@ -269,6 +296,7 @@ func Provider() *schema.Provider {
 			"github_organization_teams":                                             dataSourceGithubOrganizationTeams(),
 			"github_organization_webhooks":                                          dataSourceGithubOrganizationWebhooks(),
 			"github_organization_app_installations":                                 dataSourceGithubOrganizationAppInstallations(),
+			"github_rate_limit":                                                     dataSourceGithubRateLimit(),
 			"github_ref":                                                            dataSourceGithubRef(),
 			"github_release":                                                        dataSourceGithubRelease(),
 			"github_release_asset":                                                  dataSourceGithubReleaseAsset(),
@@ -294,6 +322,11 @@ func Provider() *schema.Provider {
 			"github_user_external_identity":                                         dataSourceGithubUserExternalIdentity(),
 			"github_users":                                                          dataSourceGithubUsers(),
 			"github_enterprise":                                                     dataSourceGithubEnterprise(),
+			"github_enterprise_audit_log":                                           dataSourceGithubEnterpriseAuditLog(),
+			"github_enterprise_audit_log_stream":                                    dataSourceGithubEnterpriseAuditLogStream(),
+			"github_enterprise_audit_log_stream_encrypted_secret":                   dataSourceGithubEnterpriseAuditLogStreamEncryptedSecret(),
+			"github_enterprise_audit_log_streams":                                   dataSourceGithubEnterpriseAuditLogStreams(),
+			"github_enterprise_organizations":                                       dataSourceGithubEnterpriseOrganizations(),
 			"github_repository_environment_deployment_policies":                     dataSourceGithubRepositoryEnvironmentDeploymentPolicies(),
 		},
 	}
@@ -336,12 +369,20 @@ func init() {
 			"While it is possible to enable this setting on github.com, " +
 			"github.com's best practices recommend using serialization to avoid hitting abuse rate limits" +
 			"Defaults to false if not set",
+		"max_idle_conns": "Maximum number of idle (keep-alive) connections kept open to the GitHub API. " +
+			"Defaults to 100 if not set.",
+		"max_idle_conns_per_host": "Maximum number of idle (keep-alive) connections kept open per host. " +
+			"Defaults to 10 if not set.",
+		"idle_conn_timeout_ms": "Amount of time in milliseconds an idle (keep-alive) connection is kept open before closing itself. " +
+			"Defaults to 90000ms or 90s if not set.",
 		"retryable_errors": "Allow the provider to retry after receiving an error status code, the max_retries should be set for this to work" +
 			"Defaults to [500, 502, 503, 504]",
 		"max_retries": "Number of times to retry a request after receiving an error status code" +
 			"Defaults to 3",
 		"max_per_page": "Number of items per page for pagination" +
 			"Defaults to 100",
+		"user_agent_suffix": "A string appended to the User-Agent header of requests to the GitHub REST API, " +
+			"for example to identify the automation making changes",
 	}
 }
 
@@ -438,7 +479,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		}
 		log.Printf("[DEBUG] Setting read_delay_ms to %d", readDelay)
 
-		retryDelay := d.Get("read_delay_ms").(int)
+		retryDelay := d.Get("retry_delay_ms").(int)
 		if retryDelay < 0 {
 			return nil, diag.FromErr(fmt.Errorf("retry_delay_ms must be greater than or equal to 0ms"))
 		}
@@ -474,6 +515,20 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 
 		log.Printf("[DEBUG] Setting parallel_requests to %t", parallelRequests)
 
+		maxIdleConns := d.Get("max_idle_conns").(int)
+		if maxIdleConns <= 0 {
+			return nil, diag.FromErr(fmt.Errorf("max_idle_conns must be greater than 0"))
+		}
+		maxIdleConnsPerHost := d.Get("max_idle_conns_per_host").(int)
+		if maxIdleConnsPerHost <= 0 {
+			return nil, diag.FromErr(fmt.Errorf("max_idle_conns_per_host must be greater than 0"))
+		}
+		idleConnTimeout := d.Get("idle_conn_timeout_ms").(int)
+		if idleConnTimeout <= 0 {
+			return nil, diag.FromErr(fmt.Errorf("idle_conn_timeout_ms must be greater than 0ms"))
+		}
+		log.Printf("[DEBUG] Setting max_idle_conns to %d, max_idle_conns_per_host to %d and idle_conn_timeout_ms to %d", maxIdleConns, maxIdleConnsPerHost, idleConnTimeout)
+
 		config := Config{
 			Token:            token,
 			BaseURL:          baseURL,
@@ -486,6 +541,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			MaxRetries:       maxRetries,
 			ParallelRequests: parallelRequests,
 			IsGHES:           isGHES,
+			UserAgentSuffix:  d.Get("user_agent_suffix").(string),
+
+			MaxIdleConns:        maxIdleConns,
+			MaxIdleConnsPerHost: maxIdleConnsPerHost,
+			IdleConnTimeout:     time.Duration(idleConnTimeout) * time.Millisecond,
 		}
 
 		meta, err := config.Meta()
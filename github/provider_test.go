@@ -205,6 +205,51 @@ data "github_ip_ranges" "test" {}
 		})
 	})
 
+	t.Run("can be configured with retry delay", func(t *testing.T) {
+		testRetryDelay := -1
+		config := fmt.Sprintf(`
+			provider "github" {
+				owner = "%s"
+				retry_delay_ms = %d
+			}
+
+			data "github_ip_ranges" "test" {}
+			`, testAccConf.owner, testRetryDelay)
+
+		resource.Test(t, resource.TestCase{
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:             config,
+					ExpectNonEmptyPlan: false,
+					ExpectError:        regexp.MustCompile("retry_delay_ms must be greater than or equal to 0ms"),
+				},
+			},
+		})
+	})
+
+	t.Run("can be configured with idle connection settings", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			provider "github" {
+				owner                   = "%s"
+				max_idle_conns_per_host = 0
+			}
+
+			data "github_ip_ranges" "test" {}
+			`, testAccConf.owner)
+
+		resource.Test(t, resource.TestCase{
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:             config,
+					ExpectNonEmptyPlan: false,
+					ExpectError:        regexp.MustCompile("max_idle_conns_per_host must be greater than 0"),
+				},
+			},
+		})
+	})
+
 	t.Run("can be configured with max per page", func(t *testing.T) {
 		testMaxPerPage := 101
 		config := fmt.Sprintf(`
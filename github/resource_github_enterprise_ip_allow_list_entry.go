@@ -0,0 +1,209 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubEnterpriseIpAllowListEntry() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage an IP allow list entry of a GitHub enterprise.",
+
+		CreateContext: resourceGithubEnterpriseIpAllowListEntryCreate,
+		ReadContext:   resourceGithubEnterpriseIpAllowListEntryRead,
+		UpdateContext: resourceGithubEnterpriseIpAllowListEntryUpdate,
+		DeleteContext: resourceGithubEnterpriseIpAllowListEntryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"allow_list_value": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "An IP address or range of addresses in CIDR notation.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.Any(validation.IsIPAddress, validation.IsCIDR)),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A name for the IP allow list entry.",
+			},
+			"is_active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the entry is active when the IP allow list is enabled.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the entry was created.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the entry was last updated.",
+			},
+		},
+	}
+}
+
+func resourceGithubEnterpriseIpAllowListEntryCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	enterpriseId, err := getEnterpriseId(ctx, client, enterpriseSlug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if enterpriseId == "" {
+		return diag.Errorf("could not find enterprise %s", enterpriseSlug)
+	}
+
+	var mutate struct {
+		CreateIpAllowListEntry struct {
+			IpAllowListEntry struct {
+				ID githubv4.ID
+			}
+		} `graphql:"createIpAllowListEntry(input: $input)"`
+	}
+
+	input := githubv4.CreateIpAllowListEntryInput{
+		OwnerID:        githubv4.ID(enterpriseId),
+		AllowListValue: githubv4.String(d.Get("allow_list_value").(string)),
+		IsActive:       githubv4.Boolean(d.Get("is_active").(bool)),
+		Name:           githubv4.NewString(githubv4.String(d.Get("name").(string))),
+	}
+
+	err = client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s", mutate.CreateIpAllowListEntry.IpAllowListEntry.ID))
+
+	return resourceGithubEnterpriseIpAllowListEntryRead(ctx, d, meta)
+}
+
+func resourceGithubEnterpriseIpAllowListEntryRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+
+	var query struct {
+		Node struct {
+			IpAllowListEntry struct {
+				ID             githubv4.ID
+				Name           githubv4.String
+				AllowListValue githubv4.String
+				IsActive       githubv4.Boolean
+				CreatedAt      githubv4.String
+				UpdatedAt      githubv4.String
+				Owner          struct {
+					Enterprise struct {
+						Slug githubv4.String
+					} `graphql:"... on Enterprise"`
+				}
+			} `graphql:"... on IpAllowListEntry"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	variables := map[string]any{
+		"id": githubv4.ID(d.Id()),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id") {
+			log.Printf("[INFO] Removing IP allow list entry (%s) from state because it no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	entry := query.Node.IpAllowListEntry
+	if entry.Owner.Enterprise.Slug == "" {
+		return diag.Errorf("IP allow list entry %s does not belong to an enterprise", d.Id())
+	}
+
+	if err = d.Set("enterprise_slug", entry.Owner.Enterprise.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_list_value", entry.AllowListValue); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", entry.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_active", entry.IsActive); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created_at", entry.CreatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("updated_at", entry.UpdatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubEnterpriseIpAllowListEntryUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+
+	var mutate struct {
+		UpdateIpAllowListEntry struct {
+			IpAllowListEntry struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updateIpAllowListEntry(input: $input)"`
+	}
+
+	input := githubv4.UpdateIpAllowListEntryInput{
+		IPAllowListEntryID: githubv4.ID(d.Id()),
+		AllowListValue:     githubv4.String(d.Get("allow_list_value").(string)),
+		IsActive:           githubv4.Boolean(d.Get("is_active").(bool)),
+		Name:               githubv4.NewString(githubv4.String(d.Get("name").(string))),
+	}
+
+	err := client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubEnterpriseIpAllowListEntryRead(ctx, d, meta)
+}
+
+func resourceGithubEnterpriseIpAllowListEntryDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+
+	var mutate struct {
+		DeleteIpAllowListEntry struct {
+			ClientMutationId githubv4.String
+		} `graphql:"deleteIpAllowListEntry(input: $input)"`
+	}
+
+	input := githubv4.DeleteIpAllowListEntryInput{
+		IPAllowListEntryID: githubv4.ID(d.Id()),
+	}
+
+	err := client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubEnterpriseIpAllowListEntry(t *testing.T) {
+	t.Run("creates, updates and imports an IP allow list entry without error", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		name := fmt.Sprintf("%sip-%s", testResourcePrefix, randomID)
+
+		config := `
+			resource "github_enterprise_ip_allow_list_entry" "test" {
+				enterprise_slug  = "%s"
+				name             = "%s"
+				allow_list_value = "%s"
+				is_active        = %t
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessEnterprise(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testAccConf.enterpriseSlug, name, "192.0.2.0/24", false),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_enterprise_ip_allow_list_entry.test", "enterprise_slug", testAccConf.enterpriseSlug),
+						resource.TestCheckResourceAttr("github_enterprise_ip_allow_list_entry.test", "name", name),
+						resource.TestCheckResourceAttr("github_enterprise_ip_allow_list_entry.test", "allow_list_value", "192.0.2.0/24"),
+						resource.TestCheckResourceAttr("github_enterprise_ip_allow_list_entry.test", "is_active", "false"),
+						resource.TestCheckResourceAttrSet("github_enterprise_ip_allow_list_entry.test", "created_at"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testAccConf.enterpriseSlug, name, "198.51.100.7", false),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_enterprise_ip_allow_list_entry.test", "allow_list_value", "198.51.100.7"),
+					),
+				},
+				{
+					ResourceName:      "github_enterprise_ip_allow_list_entry.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubEnterpriseIpAllowListSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage whether the IP allow list of a GitHub enterprise is enabled.",
+
+		CreateContext: resourceGithubEnterpriseIpAllowListSettingsCreateOrUpdate,
+		ReadContext:   resourceGithubEnterpriseIpAllowListSettingsRead,
+		UpdateContext: resourceGithubEnterpriseIpAllowListSettingsCreateOrUpdate,
+		DeleteContext: resourceGithubEnterpriseIpAllowListSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the IP allow list is enforced for the enterprise.",
+			},
+		},
+	}
+}
+
+func resourceGithubEnterpriseIpAllowListSettingsCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	settingValue := githubv4.IpAllowListEnabledSettingValueDisabled
+	if d.Get("enabled").(bool) {
+		settingValue = githubv4.IpAllowListEnabledSettingValueEnabled
+	}
+
+	log.Printf("[DEBUG] Setting IP allow list for enterprise %s to %s", enterpriseSlug, settingValue)
+	if err := updateEnterpriseIpAllowListEnabledSetting(ctx, client, enterpriseSlug, settingValue); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(enterpriseSlug)
+
+	return resourceGithubEnterpriseIpAllowListSettingsRead(ctx, d, meta)
+}
+
+func resourceGithubEnterpriseIpAllowListSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Id()
+
+	var query struct {
+		Enterprise struct {
+			ID        githubv4.String
+			OwnerInfo struct {
+				IpAllowListEnabledSetting githubv4.IpAllowListEnabledSettingValue
+			}
+		} `graphql:"enterprise(slug: $slug)"`
+	}
+
+	variables := map[string]any{
+		"slug": githubv4.String(enterpriseSlug),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if query.Enterprise.ID == "" {
+		return diag.Errorf("could not find enterprise %s", enterpriseSlug)
+	}
+
+	if err = d.Set("enterprise_slug", enterpriseSlug); err != nil {
+		return diag.FromErr(err)
+	}
+	enabled := query.Enterprise.OwnerInfo.IpAllowListEnabledSetting == githubv4.IpAllowListEnabledSettingValueEnabled
+	if err = d.Set("enabled", enabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubEnterpriseIpAllowListSettingsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Id()
+
+	log.Printf("[DEBUG] Disabling IP allow list for enterprise: %s", enterpriseSlug)
+	err := updateEnterpriseIpAllowListEnabledSetting(ctx, client, enterpriseSlug, githubv4.IpAllowListEnabledSettingValueDisabled)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func updateEnterpriseIpAllowListEnabledSetting(ctx context.Context, client *githubv4.Client, enterpriseSlug string, settingValue githubv4.IpAllowListEnabledSettingValue) error {
+	enterpriseId, err := getEnterpriseId(ctx, client, enterpriseSlug)
+	if err != nil {
+		return err
+	}
+	if enterpriseId == "" {
+		return fmt.Errorf("could not find enterprise %s", enterpriseSlug)
+	}
+
+	var mutate struct {
+		UpdateIpAllowListEnabledSetting struct {
+			ClientMutationId githubv4.String
+		} `graphql:"updateIpAllowListEnabledSetting(input: $input)"`
+	}
+
+	input := githubv4.UpdateIpAllowListEnabledSettingInput{
+		OwnerID:      githubv4.ID(enterpriseId),
+		SettingValue: settingValue,
+	}
+
+	return client.Mutate(ctx, &mutate, input, nil)
+}
@@ -0,0 +1,40 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubEnterpriseIpAllowListSettings(t *testing.T) {
+	t.Run("manages the enterprise IP allow list setting without error", func(t *testing.T) {
+		// The allow list is left disabled so the test cannot lock the test runner out of the enterprise.
+		config := fmt.Sprintf(`
+			resource "github_enterprise_ip_allow_list_settings" "test" {
+				enterprise_slug = "%s"
+				enabled         = false
+			}
+		`, testAccConf.enterpriseSlug)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessEnterprise(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_enterprise_ip_allow_list_settings.test", "enterprise_slug", testAccConf.enterpriseSlug),
+						resource.TestCheckResourceAttr("github_enterprise_ip_allow_list_settings.test", "enabled", "false"),
+					),
+				},
+				{
+					ResourceName:      "github_enterprise_ip_allow_list_settings.test",
+					ImportState:       true,
+					ImportStateId:     testAccConf.enterpriseSlug,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,300 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubEnterpriseMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the administrator role of a user in a GitHub enterprise.",
+
+		CreateContext: resourceGithubEnterpriseMemberCreate,
+		ReadContext:   resourceGithubEnterpriseMemberRead,
+		UpdateContext: resourceGithubEnterpriseMemberUpdate,
+		DeleteContext: resourceGithubEnterpriseMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"username": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
+				Description:      "The user to grant the role to.",
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateValueFunc([]string{"owner", "billing_manager"}),
+				Description:      "The role of the user within the enterprise. Must be one of 'owner' or 'billing_manager'.",
+			},
+			"pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the user has not accepted the invitation to the role yet.",
+			},
+		},
+	}
+}
+
+// enterpriseAdmin describes the administrator role, or the pending invitation
+// to one, held by a user in an enterprise.
+type enterpriseAdmin struct {
+	enterpriseId string
+	role         githubv4.EnterpriseAdministratorRole
+	invitationId githubv4.ID
+	found        bool
+	pending      bool
+}
+
+func resourceGithubEnterpriseMemberCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	username := d.Get("username").(string)
+
+	enterpriseId, err := getEnterpriseId(ctx, client, enterpriseSlug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if enterpriseId == "" {
+		return diag.Errorf("could not find enterprise %s", enterpriseSlug)
+	}
+
+	log.Printf("[DEBUG] Inviting %s to enterprise %s as %s", username, enterpriseSlug, d.Get("role"))
+	err = inviteEnterpriseAdmin(ctx, client, enterpriseId, username, d.Get("role").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(enterpriseSlug, username))
+
+	return resourceGithubEnterpriseMemberRead(ctx, d, meta)
+}
+
+func resourceGithubEnterpriseMemberRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+
+	enterpriseSlug, username, err := parseTwoPartID(d.Id(), "enterprise_slug", "username")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	admin, err := getEnterpriseAdmin(ctx, client, enterpriseSlug, username)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !admin.found {
+		log.Printf("[INFO] Removing enterprise member %s from state because they no longer hold a role in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("enterprise_slug", enterpriseSlug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("username", username); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("role", strings.ToLower(string(admin.role))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("pending", admin.pending); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubEnterpriseMemberUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	username := d.Get("username").(string)
+	role := d.Get("role").(string)
+
+	admin, err := getEnterpriseAdmin(ctx, client, enterpriseSlug, username)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if admin.pending {
+		// The role of an invitation cannot be changed, so it is replaced.
+		log.Printf("[DEBUG] Replacing the invitation of %s to enterprise %s with role %s", username, enterpriseSlug, role)
+		if err = cancelEnterpriseAdminInvitation(ctx, client, admin.invitationId); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = inviteEnterpriseAdmin(ctx, client, admin.enterpriseId, username, role); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceGithubEnterpriseMemberRead(ctx, d, meta)
+	}
+
+	var mutate struct {
+		UpdateEnterpriseAdministratorRole struct {
+			ClientMutationId githubv4.String
+		} `graphql:"updateEnterpriseAdministratorRole(input: $input)"`
+	}
+
+	input := githubv4.UpdateEnterpriseAdministratorRoleInput{
+		EnterpriseID: githubv4.ID(admin.enterpriseId),
+		Login:        githubv4.String(username),
+		Role:         githubv4.EnterpriseAdministratorRole(strings.ToUpper(role)),
+	}
+
+	log.Printf("[DEBUG] Updating role of %s in enterprise %s to %s", username, enterpriseSlug, role)
+	err = client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubEnterpriseMemberRead(ctx, d, meta)
+}
+
+func resourceGithubEnterpriseMemberDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	username := d.Get("username").(string)
+
+	admin, err := getEnterpriseAdmin(ctx, client, enterpriseSlug, username)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !admin.found {
+		return nil
+	}
+
+	if admin.pending {
+		log.Printf("[DEBUG] Cancelling the invitation of %s to enterprise %s", username, enterpriseSlug)
+		if err = cancelEnterpriseAdminInvitation(ctx, client, admin.invitationId); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	var mutate struct {
+		RemoveEnterpriseAdmin struct {
+			ClientMutationId githubv4.String
+		} `graphql:"removeEnterpriseAdmin(input: $input)"`
+	}
+
+	input := githubv4.RemoveEnterpriseAdminInput{
+		EnterpriseID: githubv4.ID(admin.enterpriseId),
+		Login:        githubv4.String(username),
+	}
+
+	log.Printf("[DEBUG] Removing %s as administrator of enterprise %s", username, enterpriseSlug)
+	err = client.Mutate(ctx, &mutate, input, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func getEnterpriseAdmin(ctx context.Context, client *githubv4.Client, enterpriseSlug, username string) (*enterpriseAdmin, error) {
+	var query struct {
+		Enterprise struct {
+			ID        githubv4.String
+			OwnerInfo struct {
+				Admins struct {
+					Edges []struct {
+						Role githubv4.EnterpriseAdministratorRole
+						Node struct {
+							Login githubv4.String
+						}
+					}
+				} `graphql:"admins(query: $login, first: 100)"`
+				PendingAdminInvitations struct {
+					Nodes []struct {
+						ID      githubv4.ID
+						Role    githubv4.EnterpriseAdministratorRole
+						Invitee struct {
+							Login githubv4.String
+						}
+					}
+				} `graphql:"pendingAdminInvitations(query: $login, first: 100)"`
+			}
+		} `graphql:"enterprise(slug: $slug)"`
+	}
+
+	variables := map[string]any{
+		"slug":  githubv4.String(enterpriseSlug),
+		"login": githubv4.String(username),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	if query.Enterprise.ID == "" {
+		return nil, fmt.Errorf("could not find enterprise %s", enterpriseSlug)
+	}
+
+	admin := &enterpriseAdmin{enterpriseId: string(query.Enterprise.ID)}
+
+	// The query argument matches partial logins, so look for an exact match.
+	for _, edge := range query.Enterprise.OwnerInfo.Admins.Edges {
+		if strings.EqualFold(string(edge.Node.Login), username) {
+			admin.role = edge.Role
+			admin.found = true
+			return admin, nil
+		}
+	}
+	for _, invitation := range query.Enterprise.OwnerInfo.PendingAdminInvitations.Nodes {
+		if strings.EqualFold(string(invitation.Invitee.Login), username) {
+			admin.role = invitation.Role
+			admin.invitationId = invitation.ID
+			admin.found = true
+			admin.pending = true
+			return admin, nil
+		}
+	}
+
+	return admin, nil
+}
+
+func inviteEnterpriseAdmin(ctx context.Context, client *githubv4.Client, enterpriseId, username, role string) error {
+	var mutate struct {
+		InviteEnterpriseAdmin struct {
+			ClientMutationId githubv4.String
+		} `graphql:"inviteEnterpriseAdmin(input: $input)"`
+	}
+
+	adminRole := githubv4.EnterpriseAdministratorRole(strings.ToUpper(role))
+	input := githubv4.InviteEnterpriseAdminInput{
+		EnterpriseID: githubv4.ID(enterpriseId),
+		Invitee:      githubv4.NewString(githubv4.String(username)),
+		Role:         &adminRole,
+	}
+
+	return client.Mutate(ctx, &mutate, input, nil)
+}
+
+func cancelEnterpriseAdminInvitation(ctx context.Context, client *githubv4.Client, invitationId githubv4.ID) error {
+	var mutate struct {
+		CancelEnterpriseAdminInvitation struct {
+			ClientMutationId githubv4.String
+		} `graphql:"cancelEnterpriseAdminInvitation(input: $input)"`
+	}
+
+	input := githubv4.CancelEnterpriseAdminInvitationInput{
+		InvitationID: invitationId,
+	}
+
+	return client.Mutate(ctx, &mutate, input, nil)
+}
@@ -0,0 +1,50 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubEnterpriseMember(t *testing.T) {
+	t.Run("invites a user to an enterprise role without error", func(t *testing.T) {
+		if len(testAccConf.testExternalUser) == 0 {
+			t.Skip("No external user provided")
+		}
+
+		config := `
+			resource "github_enterprise_member" "test" {
+				enterprise_slug = "%s"
+				username        = "%s"
+				role            = "%s"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessEnterprise(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testAccConf.enterpriseSlug, testAccConf.testExternalUser, "billing_manager"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_enterprise_member.test", "id", fmt.Sprintf("%s:%s", testAccConf.enterpriseSlug, testAccConf.testExternalUser)),
+						resource.TestCheckResourceAttr("github_enterprise_member.test", "role", "billing_manager"),
+						resource.TestCheckResourceAttr("github_enterprise_member.test", "pending", "true"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testAccConf.enterpriseSlug, testAccConf.testExternalUser, "owner"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_enterprise_member.test", "role", "owner"),
+					),
+				},
+				{
+					ResourceName:      "github_enterprise_member.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,238 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubEnterpriseOrganizationSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the organization policies enforced by a GitHub enterprise.",
+
+		CreateContext: resourceGithubEnterpriseOrganizationSettingsCreateOrUpdate,
+		ReadContext:   resourceGithubEnterpriseOrganizationSettingsRead,
+		UpdateContext: resourceGithubEnterpriseOrganizationSettingsCreateOrUpdate,
+		DeleteContext: resourceGithubEnterpriseOrganizationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubEnterpriseOrganizationSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The slug of the enterprise.",
+			},
+			"default_repository_permission": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The base repository permission for members of every organization in the enterprise. Can be one of 'no_policy', 'none', 'read', 'write' or 'admin'.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"no_policy", "none", "read", "write", "admin"}, false)),
+			},
+			"members_can_create_repositories": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Which repositories members of every organization in the enterprise can create. Can be one of 'no_policy', 'all', 'public', 'private' or 'disabled'.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"no_policy", "all", "public", "private", "disabled"}, false)),
+			},
+		},
+	}
+}
+
+func resourceGithubEnterpriseOrganizationSettingsCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	enterpriseId, err := getEnterpriseId(ctx, client, enterpriseSlug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if enterpriseId == "" {
+		return diag.Errorf("could not find enterprise %s", enterpriseSlug)
+	}
+
+	// A policy removed from the configuration is handed back to the organization owners.
+	if d.HasChange("default_repository_permission") {
+		value := d.Get("default_repository_permission").(string)
+		if value == "" {
+			value = "no_policy"
+		}
+		log.Printf("[DEBUG] Setting default repository permission for enterprise %s to %s", enterpriseSlug, value)
+		err = updateEnterpriseDefaultRepositoryPermissionSetting(ctx, client, enterpriseId, value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("members_can_create_repositories") {
+		value := d.Get("members_can_create_repositories").(string)
+		if value == "" {
+			value = "no_policy"
+		}
+		log.Printf("[DEBUG] Setting members can create repositories for enterprise %s to %s", enterpriseSlug, value)
+		err = updateEnterpriseMembersCanCreateRepositoriesSetting(ctx, client, enterpriseId, value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(enterpriseSlug)
+
+	return resourceGithubEnterpriseOrganizationSettingsRead(ctx, d, meta)
+}
+
+// Only the settings present in the configuration are managed, so Read refreshes
+// those and leaves the others unset. This lets Delete reset exactly the
+// policies Terraform set.
+func resourceGithubEnterpriseOrganizationSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Id()
+
+	settings, err := getEnterpriseOrganizationSettings(ctx, client, enterpriseSlug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("enterprise_slug", enterpriseSlug); err != nil {
+		return diag.FromErr(err)
+	}
+	for key, value := range settings {
+		if _, ok := d.GetOk(key); !ok {
+			continue
+		}
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubEnterpriseOrganizationSettingsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Id()
+
+	settings, err := getEnterpriseOrganizationSettings(ctx, client, enterpriseSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set("enterprise_slug", enterpriseSlug); err != nil {
+		return nil, err
+	}
+	for key, value := range settings {
+		if err = d.Set(key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceGithubEnterpriseOrganizationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	enterpriseSlug := d.Id()
+
+	enterpriseId, err := getEnterpriseId(ctx, client, enterpriseSlug)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if enterpriseId == "" {
+		return diag.Errorf("could not find enterprise %s", enterpriseSlug)
+	}
+
+	// Removing the enterprise policies hands the decision back to the organization owners.
+	// Policies Terraform did not set are left untouched.
+	log.Printf("[DEBUG] Removing organization policies for enterprise: %s", enterpriseSlug)
+	if _, ok := d.GetOk("default_repository_permission"); ok {
+		if err = updateEnterpriseDefaultRepositoryPermissionSetting(ctx, client, enterpriseId, "no_policy"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if _, ok := d.GetOk("members_can_create_repositories"); ok {
+		if err = updateEnterpriseMembersCanCreateRepositoriesSetting(ctx, client, enterpriseId, "no_policy"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// getEnterpriseOrganizationSettings returns the organization policies of the
+// enterprise, keyed by attribute name.
+func getEnterpriseOrganizationSettings(ctx context.Context, client *githubv4.Client, enterpriseSlug string) (map[string]string, error) {
+	var query struct {
+		Enterprise struct {
+			ID        githubv4.String
+			OwnerInfo struct {
+				DefaultRepositoryPermissionSetting  githubv4.EnterpriseDefaultRepositoryPermissionSettingValue
+				MembersCanCreateRepositoriesSetting githubv4.EnterpriseMembersCanCreateRepositoriesSettingValue
+			}
+		} `graphql:"enterprise(slug: $slug)"`
+	}
+
+	variables := map[string]any{
+		"slug": githubv4.String(enterpriseSlug),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	if query.Enterprise.ID == "" {
+		return nil, fmt.Errorf("could not find enterprise %s", enterpriseSlug)
+	}
+
+	ownerInfo := query.Enterprise.OwnerInfo
+	return map[string]string{
+		"default_repository_permission":   strings.ToLower(string(ownerInfo.DefaultRepositoryPermissionSetting)),
+		"members_can_create_repositories": strings.ToLower(string(ownerInfo.MembersCanCreateRepositoriesSetting)),
+	}, nil
+}
+
+func updateEnterpriseDefaultRepositoryPermissionSetting(ctx context.Context, client *githubv4.Client, enterpriseId, value string) error {
+	var mutate struct {
+		UpdateEnterpriseDefaultRepositoryPermissionSetting struct {
+			ClientMutationId githubv4.String
+		} `graphql:"updateEnterpriseDefaultRepositoryPermissionSetting(input: $input)"`
+	}
+
+	input := githubv4.UpdateEnterpriseDefaultRepositoryPermissionSettingInput{
+		EnterpriseID: githubv4.ID(enterpriseId),
+		SettingValue: githubv4.EnterpriseDefaultRepositoryPermissionSettingValue(strings.ToUpper(value)),
+	}
+
+	if err := client.Mutate(ctx, &mutate, input, nil); err != nil {
+		return fmt.Errorf("error updating default repository permission: %w", err)
+	}
+
+	return nil
+}
+
+func updateEnterpriseMembersCanCreateRepositoriesSetting(ctx context.Context, client *githubv4.Client, enterpriseId, value string) error {
+	var mutate struct {
+		UpdateEnterpriseMembersCanCreateRepositoriesSetting struct {
+			ClientMutationId githubv4.String
+		} `graphql:"updateEnterpriseMembersCanCreateRepositoriesSetting(input: $input)"`
+	}
+
+	settingValue := githubv4.EnterpriseMembersCanCreateRepositoriesSettingValue(strings.ToUpper(value))
+	input := githubv4.UpdateEnterpriseMembersCanCreateRepositoriesSettingInput{
+		EnterpriseID: githubv4.ID(enterpriseId),
+		SettingValue: &settingValue,
+	}
+
+	if err := client.Mutate(ctx, &mutate, input, nil); err != nil {
+		return fmt.Errorf("error updating members can create repositories setting: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGithubEnterpriseOrganizationSettings(t *testing.T) {
+	t.Run("manages the enterprise organization policies without error", func(t *testing.T) {
+		config := `
+			resource "github_enterprise_organization_settings" "test" {
+				enterprise_slug                 = "%s"
+				default_repository_permission   = "%s"
+				members_can_create_repositories = "%s"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessEnterprise(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testAccConf.enterpriseSlug, "read", "private"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_enterprise_organization_settings.test", "enterprise_slug", testAccConf.enterpriseSlug),
+						resource.TestCheckResourceAttr("github_enterprise_organization_settings.test", "default_repository_permission", "read"),
+						resource.TestCheckResourceAttr("github_enterprise_organization_settings.test", "members_can_create_repositories", "private"),
+					),
+				},
+				{
+					ResourceName:      "github_enterprise_organization_settings.test",
+					ImportState:       true,
+					ImportStateId:     testAccConf.enterpriseSlug,
+					ImportStateVerify: true,
+				},
+				{
+					Config: fmt.Sprintf(`
+						resource "github_enterprise_organization_settings" "test" {
+							enterprise_slug               = "%s"
+							default_repository_permission = "read"
+						}
+					`, testAccConf.enterpriseSlug),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_enterprise_organization_settings.test", "default_repository_permission", "read"),
+						resource.TestCheckResourceAttr("github_enterprise_organization_settings.test", "members_can_create_repositories", ""),
+						func(_ *terraform.State) error {
+							meta, err := getTestMeta()
+							if err != nil {
+								return err
+							}
+
+							settings, err := getEnterpriseOrganizationSettings(t.Context(), meta.v4client, testAccConf.enterpriseSlug)
+							if err != nil {
+								return err
+							}
+							if settings["members_can_create_repositories"] != "no_policy" {
+								return fmt.Errorf("expected members_can_create_repositories to be reset to no_policy, got %s", settings["members_can_create_repositories"])
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+}